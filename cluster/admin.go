@@ -0,0 +1,71 @@
+package cluster
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// AdminServer exposes an HTTP surface for cluster administration: joining
+// and removing nodes, and inspecting the current leader.
+type AdminServer struct {
+	store *RaftStore
+}
+
+// NewAdminServer returns an AdminServer backed by store.
+func NewAdminServer(store *RaftStore) *AdminServer {
+	return &AdminServer{store: store}
+}
+
+// ServeHTTP implements http.Handler.
+func (a *AdminServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/join":
+		a.handleJoin(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == "/leave":
+		a.handleLeave(w, r)
+	case r.Method == http.MethodGet && r.URL.Path == "/leader":
+		a.handleLeader(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+type joinRequest struct {
+	ID   string `json:"id"`
+	Addr string `json:"addr"`
+}
+
+func (a *AdminServer) handleJoin(w http.ResponseWriter, r *http.Request) {
+	var req joinRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "decode request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := a.store.Join(req.ID, req.Addr); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type leaveRequest struct {
+	ID string `json:"id"`
+}
+
+func (a *AdminServer) handleLeave(w http.ResponseWriter, r *http.Request) {
+	var req leaveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "decode request: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := a.store.Leave(req.ID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a *AdminServer) handleLeader(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"leader": string(a.store.Leader())})
+}
@@ -0,0 +1,113 @@
+package cluster
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/tinywasm/skill"
+)
+
+func newSingleNodeStore(t *testing.T) *RaftStore {
+	t.Helper()
+	dir := t.TempDir()
+
+	store, err := NewRaftStore(Config{
+		NodeID:    "node1",
+		RaftDir:   dir,
+		RaftAddr:  "127.0.0.1:0",
+		DBPath:    filepath.Join(dir, "skill.sqlite"),
+		Bootstrap: true,
+	})
+	if err != nil {
+		t.Fatalf("NewRaftStore failed: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	waitForLeader(t, store)
+	return store
+}
+
+func waitForLeader(t *testing.T, store *RaftStore) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if store.raft.State() == raft.Leader {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatal("timed out waiting for node to become leader")
+}
+
+func TestSingleNodeRegisterAndRead(t *testing.T) {
+	store := newSingleNodeStore(t)
+	ctx := context.Background()
+
+	sk := skill.Skill{Category: "Data", Name: "convert_format", Description: "Convert file format"}
+	if err := store.Register(ctx, sk); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	got, err := store.GetSkillDetail(ctx, "convert_format", None)
+	if err != nil {
+		t.Fatalf("GetSkillDetail failed: %v", err)
+	}
+	if got.Name != "convert_format" {
+		t.Errorf("expected skill 'convert_format', got %q", got.Name)
+	}
+}
+
+func TestStrongConsistencySucceedsOnLeader(t *testing.T) {
+	store := newSingleNodeStore(t)
+
+	if err := store.ensureConsistency(Strong); err != nil {
+		t.Errorf("expected strong consistency to succeed on the leader, got: %v", err)
+	}
+}
+
+func TestJoinReplicatesToFollower(t *testing.T) {
+	leader := newSingleNodeStore(t)
+
+	followerDir := t.TempDir()
+	follower, err := NewRaftStore(Config{
+		NodeID:   "node2",
+		RaftDir:  followerDir,
+		RaftAddr: "127.0.0.1:0",
+		DBPath:   filepath.Join(followerDir, "skill.sqlite"),
+	})
+	if err != nil {
+		t.Fatalf("NewRaftStore (follower) failed: %v", err)
+	}
+	t.Cleanup(func() { follower.Close() })
+
+	if err := leader.Join("node2", string(follower.Addr())); err != nil {
+		t.Fatalf("Join failed: %v", err)
+	}
+
+	ctx := context.Background()
+	sk := skill.Skill{Category: "Data", Name: "convert_format", Description: "Convert file format"}
+	if err := leader.Register(ctx, sk); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	// Replication to the follower happens asynchronously once the leader's
+	// write commits, so poll for it to land in the follower's local store.
+	deadline := time.Now().Add(5 * time.Second)
+	var got *skill.Skill
+	for time.Now().Before(deadline) {
+		got, err = follower.GetSkillDetail(ctx, "convert_format", None)
+		if err == nil {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("GetSkillDetail on follower never saw the replicated skill: %v", err)
+	}
+	if got.Name != "convert_format" {
+		t.Errorf("expected skill 'convert_format', got %q", got.Name)
+	}
+}
@@ -0,0 +1,39 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tinywasm/skill"
+)
+
+// commandOp identifies which Store method a Command replays.
+type commandOp string
+
+const (
+	opRegister commandOp = "register"
+)
+
+// Command is the unit of work replicated through raft. Every mutating
+// RaftStore call is encoded as a Command and applied deterministically by
+// the FSM on every node against its local SQLite file.
+type Command struct {
+	Op    commandOp   `json:"op"`
+	Skill skill.Skill `json:"skill,omitempty"`
+}
+
+func encodeCommand(cmd Command) ([]byte, error) {
+	b, err := json.Marshal(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("encode command: %w", err)
+	}
+	return b, nil
+}
+
+func decodeCommand(b []byte) (Command, error) {
+	var cmd Command
+	if err := json.Unmarshal(b, &cmd); err != nil {
+		return Command{}, fmt.Errorf("decode command: %w", err)
+	}
+	return cmd, nil
+}
@@ -0,0 +1,47 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/raft"
+	"github.com/tinywasm/skill"
+)
+
+// fsm applies replicated Commands to a local *skill.Store backed by a
+// SQLite file. Every node runs an identical fsm against its own copy of the
+// database, so after Apply all nodes converge to the same state.
+type fsm struct {
+	store *skill.Store
+	path  string // path to the local SQLite file, for snapshot/restore
+}
+
+// Apply implements raft.FSM.
+func (f *fsm) Apply(log *raft.Log) interface{} {
+	cmd, err := decodeCommand(log.Data)
+	if err != nil {
+		return err
+	}
+
+	switch cmd.Op {
+	case opRegister:
+		return f.store.Register(context.Background(), cmd.Skill)
+	default:
+		return fmt.Errorf("unknown command op: %s", cmd.Op)
+	}
+}
+
+// Snapshot implements raft.FSM using SQLite's online backup facility (via
+// VACUUM INTO) to take a consistent copy of the database file without
+// blocking writers.
+func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
+	return &fsmSnapshot{path: f.path}, nil
+}
+
+// Restore implements raft.FSM, replacing the local database file wholesale
+// with the snapshot contents.
+func (f *fsm) Restore(rc io.ReadCloser) error {
+	defer rc.Close()
+	return restoreSQLiteFile(f.path, rc)
+}
@@ -0,0 +1,251 @@
+package cluster
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/raft"
+	raftboltdb "github.com/hashicorp/raft-boltdb"
+	"github.com/tinywasm/skill"
+
+	_ "modernc.org/sqlite"
+)
+
+// Consistency controls how a RaftStore read is served.
+type Consistency int
+
+const (
+	// None serves the read from this node's local state without
+	// coordinating with the rest of the cluster. Fastest, may be stale.
+	None Consistency = iota
+	// Weak serves the read locally but first confirms this node's raft
+	// instance is still running.
+	Weak
+	// Strong waits for a barrier before serving the read locally, so it
+	// reflects every write committed before the read was issued. Strong is
+	// leader-only: it does not forward the request over the network, so
+	// callers must issue it against the current leader (see RaftStore.Leader)
+	// or it returns an error.
+	Strong
+)
+
+// RaftStore wraps a *skill.Store so that Register is replicated via raft
+// across a cluster of skilld nodes, while reads can be served from any
+// node according to Consistency. Existing single-node skill.Store usage is
+// unaffected; RaftStore is an additive way to run skill as an HA cluster.
+type RaftStore struct {
+	raft  *raft.Raft
+	fsm   *fsm
+	local *skill.Store
+	addr  raft.ServerAddress
+}
+
+// Config configures a new RaftStore node.
+type Config struct {
+	// NodeID uniquely identifies this node within the cluster.
+	NodeID string
+	// RaftDir holds this node's raft log, stable store, and snapshots.
+	RaftDir string
+	// RaftAddr is the address other nodes use to reach this node's raft
+	// transport.
+	RaftAddr string
+	// DBPath is the path to this node's local SQLite database file.
+	DBPath string
+	// Bootstrap starts a brand new single-node cluster. Set this only for
+	// the very first node; later nodes should join via Join instead.
+	Bootstrap bool
+}
+
+// NewRaftStore opens the local SQLite database at cfg.DBPath, migrates it,
+// and starts a raft node over it.
+func NewRaftStore(cfg Config) (*RaftStore, error) {
+	db, err := sql.Open("sqlite", cfg.DBPath)
+	if err != nil {
+		return nil, fmt.Errorf("open local db: %w", err)
+	}
+	local := skill.NewStore(db)
+	if err := local.Migrate(context.Background()); err != nil {
+		return nil, fmt.Errorf("migrate local db: %w", err)
+	}
+
+	f := &fsm{store: local, path: cfg.DBPath}
+
+	raftConfig := raft.DefaultConfig()
+	raftConfig.LocalID = raft.ServerID(cfg.NodeID)
+
+	addr, err := net.ResolveTCPAddr("tcp", cfg.RaftAddr)
+	if err != nil {
+		return nil, fmt.Errorf("resolve raft addr: %w", err)
+	}
+	// TCPStreamLayer.Addr() prefers an explicit advertise address over the
+	// listener's own address, so passing the unresolved ":0" port through
+	// would make every ephemeral-port node advertise port 0. Leave advertise
+	// nil in that case so raft reports the listener's actual bound address.
+	var advertise net.Addr
+	if addr.Port != 0 {
+		advertise = addr
+	}
+	transport, err := raft.NewTCPTransport(cfg.RaftAddr, advertise, 3, 10*time.Second, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create raft transport: %w", err)
+	}
+
+	snapshots, err := raft.NewFileSnapshotStore(cfg.RaftDir, 2, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create snapshot store: %w", err)
+	}
+
+	logStore, err := raftboltdb.NewBoltStore(cfg.RaftDir + "/raft-log.bolt")
+	if err != nil {
+		return nil, fmt.Errorf("create log store: %w", err)
+	}
+	stableStore, err := raftboltdb.NewBoltStore(cfg.RaftDir + "/raft-stable.bolt")
+	if err != nil {
+		return nil, fmt.Errorf("create stable store: %w", err)
+	}
+
+	r, err := raft.NewRaft(raftConfig, f, logStore, stableStore, snapshots, transport)
+	if err != nil {
+		return nil, fmt.Errorf("create raft node: %w", err)
+	}
+
+	if cfg.Bootstrap {
+		r.BootstrapCluster(raft.Configuration{
+			Servers: []raft.Server{
+				{ID: raftConfig.LocalID, Address: transport.LocalAddr()},
+			},
+		})
+	}
+
+	return &RaftStore{raft: r, fsm: f, local: local, addr: transport.LocalAddr()}, nil
+}
+
+// Addr returns the address other nodes should use to reach this node's
+// raft transport when calling Join.
+func (rs *RaftStore) Addr() raft.ServerAddress {
+	return rs.addr
+}
+
+// Register replicates sk through raft and applies it to every node's local
+// store. It must be called on the leader, and blocks until the write is
+// committed to a quorum.
+func (rs *RaftStore) Register(ctx context.Context, sk skill.Skill) error {
+	if rs.raft.State() != raft.Leader {
+		return fmt.Errorf("register: not the leader, current leader is %q", rs.Leader())
+	}
+
+	b, err := encodeCommand(Command{Op: opRegister, Skill: sk})
+	if err != nil {
+		return err
+	}
+
+	timeout := 10 * time.Second
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	future := rs.raft.Apply(b, timeout)
+	if err := future.Error(); err != nil {
+		return fmt.Errorf("apply register command: %w", err)
+	}
+	if err, ok := future.Response().(error); ok && err != nil {
+		return fmt.Errorf("register: %w", err)
+	}
+	return nil
+}
+
+// ListCategories lists categories, honoring consistency.
+func (rs *RaftStore) ListCategories(ctx context.Context, consistency Consistency) ([]skill.Category, error) {
+	if err := rs.ensureConsistency(consistency); err != nil {
+		return nil, err
+	}
+	return rs.local.ListCategories(ctx)
+}
+
+// ListSkillsByCategory lists skills under categoryName, honoring consistency.
+func (rs *RaftStore) ListSkillsByCategory(ctx context.Context, categoryName string, consistency Consistency) ([]skill.Skill, error) {
+	if err := rs.ensureConsistency(consistency); err != nil {
+		return nil, err
+	}
+	return rs.local.ListSkillsByCategory(ctx, categoryName)
+}
+
+// GetSkillDetail retrieves a skill by name, honoring consistency.
+func (rs *RaftStore) GetSkillDetail(ctx context.Context, name string, consistency Consistency) (*skill.Skill, error) {
+	if err := rs.ensureConsistency(consistency); err != nil {
+		return nil, err
+	}
+	return rs.local.GetSkillDetail(ctx, name)
+}
+
+// SearchSkills searches for skills, honoring consistency.
+func (rs *RaftStore) SearchSkills(ctx context.Context, opts skill.SearchOptions, consistency Consistency) ([]skill.SearchResult, error) {
+	if err := rs.ensureConsistency(consistency); err != nil {
+		return nil, err
+	}
+	return rs.local.SearchSkills(ctx, opts)
+}
+
+// ensureConsistency enforces the requested read Consistency before a read
+// is served from this node's local store.
+func (rs *RaftStore) ensureConsistency(consistency Consistency) error {
+	switch consistency {
+	case None:
+		return nil
+	case Weak:
+		if rs.raft.State() == raft.Shutdown {
+			return fmt.Errorf("ensure consistency: raft node is shut down")
+		}
+		return nil
+	case Strong:
+		// Strong is leader-only and does not forward reads over the
+		// network: callers must issue it against the current leader.
+		if rs.raft.State() != raft.Leader {
+			return fmt.Errorf("ensure consistency: strong reads must be issued against the leader, current leader is %q", rs.Leader())
+		}
+		if err := rs.raft.Barrier(10 * time.Second).Error(); err != nil {
+			return fmt.Errorf("ensure consistency: barrier: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("ensure consistency: unknown consistency level %d", consistency)
+	}
+}
+
+// Join adds the node with the given id, reachable at addr, as a voter in
+// the cluster. Must be called on the leader.
+func (rs *RaftStore) Join(id, addr string) error {
+	if rs.raft.State() != raft.Leader {
+		return fmt.Errorf("join: not the leader, current leader is %q", rs.Leader())
+	}
+	if err := rs.raft.AddVoter(raft.ServerID(id), raft.ServerAddress(addr), 0, 0).Error(); err != nil {
+		return fmt.Errorf("join: %w", err)
+	}
+	return nil
+}
+
+// Leave removes the node with the given id from the cluster. Must be
+// called on the leader.
+func (rs *RaftStore) Leave(id string) error {
+	if rs.raft.State() != raft.Leader {
+		return fmt.Errorf("leave: not the leader, current leader is %q", rs.Leader())
+	}
+	if err := rs.raft.RemoveServer(raft.ServerID(id), 0, 0).Error(); err != nil {
+		return fmt.Errorf("leave: %w", err)
+	}
+	return nil
+}
+
+// Leader returns the address of the current raft leader, or "" if unknown.
+func (rs *RaftStore) Leader() raft.ServerAddress {
+	addr, _ := rs.raft.LeaderWithID()
+	return addr
+}
+
+// Close shuts down this node's raft instance.
+func (rs *RaftStore) Close() error {
+	return rs.raft.Shutdown().Error()
+}
@@ -0,0 +1,462 @@
+package skill
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Dialect abstracts the SQL differences between the database backends that
+// Store supports (SQLite, Postgres, MySQL): schema DDL, placeholder syntax,
+// and the upsert strategy used by Register.
+type Dialect interface {
+	// Name identifies the dialect, used in error messages.
+	Name() string
+	// Schema returns the DDL that creates the categories/skills/parameters tables.
+	Schema() string
+	// Rebind rewrites a query written with "?" placeholders into this
+	// dialect's native placeholder syntax (e.g. "$1" for Postgres).
+	Rebind(query string) string
+	// UpsertCategory inserts the category if it doesn't already exist and
+	// returns its id.
+	UpsertCategory(ctx context.Context, tx *sql.Tx, name string) (int64, error)
+	// UpsertSkill inserts a new skill or updates an existing one (matched by
+	// name), returning its id.
+	UpsertSkill(ctx context.Context, tx *sql.Tx, categoryID int64, name, description string) (int64, error)
+	// Search runs a full-text search for opts against the skills table,
+	// using whichever search mechanism this dialect provides.
+	Search(ctx context.Context, db Queryer, opts SearchOptions) ([]SearchResult, error)
+}
+
+// Queryer is satisfied by *sql.DB and *sql.Tx. Dialect implementations
+// accept it so Search can run against either.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// SearchOptions configures Store.SearchSkills.
+type SearchOptions struct {
+	// Query is the free-text search query.
+	Query string
+	// Category restricts results to a single category name. Empty means
+	// search across every category.
+	Category string
+	// Limit caps the number of results returned. Zero means no cap.
+	Limit int
+	// Offset skips the first N matches, for pagination. It is applied only
+	// when Limit is positive; with Limit zero, Offset is ignored.
+	Offset int
+	// MinScore discards results scoring below this threshold.
+	MinScore float64
+}
+
+// SearchResult is a single SearchSkills match, with its relevance score and
+// a highlighted snippet of the matching text.
+type SearchResult struct {
+	Skill   Skill
+	Score   float64
+	Snippet string
+}
+
+// dialectsByDriverSubstring maps a substring of the database/sql driver's
+// package path or type name (e.g. "github.com/jackc/pgx/v5/stdlib.Driver")
+// to the Dialect that should be used with it. NewStore consults this when no
+// WithDialect option is given.
+var dialectsByDriverSubstring = map[string]func() Dialect{
+	"sqlite":   func() Dialect { return SQLiteDialect{} },
+	"postgres": func() Dialect { return PostgresDialect{} },
+	"pgx":      func() Dialect { return PostgresDialect{} },
+	"mysql":    func() Dialect { return MySQLDialect{} },
+}
+
+// detectDialect guesses the Dialect to use from the concrete type of db's
+// driver. database/sql does not expose the driver name it was opened with,
+// so this matches on the driver's package path and type name; callers that
+// need certainty should pass WithDialect explicitly instead.
+func detectDialect(db *sql.DB) Dialect {
+	t := reflect.TypeOf(db.Driver())
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	typeName := t.PkgPath() + "." + t.Name()
+	for substr, ctor := range dialectsByDriverSubstring {
+		if strings.Contains(strings.ToLower(typeName), substr) {
+			return ctor()
+		}
+	}
+	return SQLiteDialect{}
+}
+
+// rebindDollar rewrites a "?"-style query into "$1", "$2", ... placeholders,
+// in the style of sqlx's Rebind for sqlx.DOLLAR.
+func rebindDollar(query string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SQLiteDialect targets SQLite and is Store's default dialect.
+type SQLiteDialect struct{}
+
+// Name implements Dialect.
+func (SQLiteDialect) Name() string { return "sqlite" }
+
+// Schema implements Dialect by concatenating the "up" side of every
+// embedded migration, in version order. This is the same migration set
+// Store.Migrate applies, so a database built from GetSchemaDescription and
+// one built by running migrations from scratch can never drift apart.
+func (SQLiteDialect) Schema() string {
+	migrations, err := loadMigrations()
+	if err != nil {
+		// migrationFS is compiled into the binary, so this can only fail if
+		// a migration file was added without following the
+		// NNNN_name.up.sql naming convention.
+		panic(fmt.Sprintf("sqlite schema: %v", err))
+	}
+	var b strings.Builder
+	for _, m := range migrations {
+		b.WriteString(m.up)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Rebind implements Dialect. SQLite uses "?" placeholders natively.
+func (SQLiteDialect) Rebind(query string) string { return query }
+
+// UpsertCategory implements Dialect.
+func (SQLiteDialect) UpsertCategory(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO categories (name, description) VALUES (?, '')
+		ON CONFLICT(name) DO NOTHING
+	`, name); err != nil {
+		return 0, fmt.Errorf("upsert category: %w", err)
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM categories WHERE name = ?", name).Scan(&id); err != nil {
+		return 0, fmt.Errorf("get category id: %w", err)
+	}
+	return id, nil
+}
+
+// UpsertSkill implements Dialect.
+func (SQLiteDialect) UpsertSkill(ctx context.Context, tx *sql.Tx, categoryID int64, name, description string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO skills (category_id, name, description)
+		VALUES (?, ?, ?)
+		ON CONFLICT(name) DO UPDATE SET
+			category_id = excluded.category_id,
+			description = excluded.description
+		RETURNING id
+	`, categoryID, name, description).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("upsert skill: %w", err)
+	}
+	return id, nil
+}
+
+// Search implements Dialect using the skills_fts FTS5 virtual table
+// maintained by the 0003_add_fts migration. bm25() ranks lower-is-better,
+// so the raw score is negated to match the higher-is-better convention the
+// other dialects' rank functions use.
+func (SQLiteDialect) Search(ctx context.Context, db Queryer, opts SearchOptions) ([]SearchResult, error) {
+	query := `
+		SELECT s.id, c.name, s.name, s.description,
+			bm25(skills_fts) AS raw_score,
+			snippet(skills_fts, 1, '[', ']', '...', 10) AS snippet
+		FROM skills_fts
+		JOIN skills s ON s.id = skills_fts.rowid
+		JOIN categories c ON s.category_id = c.id
+		WHERE skills_fts MATCH ?
+	`
+	args := []any{opts.Query}
+	if opts.Category != "" {
+		query += " AND c.name = ?"
+		args = append(args, opts.Category)
+	}
+	query += " ORDER BY raw_score"
+	if opts.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, opts.Limit, opts.Offset)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search skills: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var (
+			r        SearchResult
+			rawScore float64
+		)
+		if err := rows.Scan(&r.Skill.ID, &r.Skill.Category, &r.Skill.Name, &r.Skill.Description, &rawScore, &r.Snippet); err != nil {
+			return nil, fmt.Errorf("scan search result: %w", err)
+		}
+		r.Score = -rawScore
+		if r.Score < opts.MinScore {
+			continue
+		}
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate search results: %w", err)
+	}
+	return results, nil
+}
+
+// PostgresDialect targets PostgreSQL.
+type PostgresDialect struct{}
+
+// Name implements Dialect.
+func (PostgresDialect) Name() string { return "postgres" }
+
+// Schema implements Dialect.
+func (PostgresDialect) Schema() string {
+	return `
+CREATE TABLE categories (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT
+);
+
+CREATE TABLE skills (
+    id SERIAL PRIMARY KEY,
+    category_id INTEGER REFERENCES categories(id),
+    name TEXT NOT NULL UNIQUE,
+    description TEXT,
+    search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', coalesce(name, '') || ' ' || coalesce(description, ''))) STORED
+);
+
+CREATE INDEX idx_skills_search_vector ON skills USING GIN (search_vector);
+
+CREATE TABLE parameters (
+    id SERIAL PRIMARY KEY,
+    skill_id INTEGER REFERENCES skills(id),
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    description TEXT,
+    is_required BOOLEAN DEFAULT FALSE,
+    enum_values TEXT,
+    default_value TEXT,
+    items_type TEXT
+);
+`
+}
+
+// Rebind implements Dialect, rewriting "?" into "$1", "$2", ....
+func (PostgresDialect) Rebind(query string) string { return rebindDollar(query) }
+
+// UpsertCategory implements Dialect.
+func (PostgresDialect) UpsertCategory(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	if _, err := tx.ExecContext(ctx, rebindDollar(`
+		INSERT INTO categories (name, description) VALUES (?, '')
+		ON CONFLICT (name) DO NOTHING
+	`), name); err != nil {
+		return 0, fmt.Errorf("upsert category: %w", err)
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, rebindDollar("SELECT id FROM categories WHERE name = ?"), name).Scan(&id); err != nil {
+		return 0, fmt.Errorf("get category id: %w", err)
+	}
+	return id, nil
+}
+
+// UpsertSkill implements Dialect.
+func (PostgresDialect) UpsertSkill(ctx context.Context, tx *sql.Tx, categoryID int64, name, description string) (int64, error) {
+	var id int64
+	err := tx.QueryRowContext(ctx, rebindDollar(`
+		INSERT INTO skills (category_id, name, description)
+		VALUES (?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET
+			category_id = excluded.category_id,
+			description = excluded.description
+		RETURNING id
+	`), categoryID, name, description).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("upsert skill: %w", err)
+	}
+	return id, nil
+}
+
+// Search implements Dialect using the generated tsvector column and GIN
+// index declared in PostgresDialect.Schema.
+func (PostgresDialect) Search(ctx context.Context, db Queryer, opts SearchOptions) ([]SearchResult, error) {
+	query := `
+		SELECT s.id, c.name, s.name, s.description,
+			ts_rank_cd(s.search_vector, websearch_to_tsquery('english', ?)) AS score,
+			ts_headline('english', s.description, websearch_to_tsquery('english', ?), 'StartSel=[, StopSel=]')
+		FROM skills s
+		JOIN categories c ON s.category_id = c.id
+		WHERE s.search_vector @@ websearch_to_tsquery('english', ?)
+	`
+	args := []any{opts.Query, opts.Query, opts.Query}
+	if opts.Category != "" {
+		query += " AND c.name = ?"
+		args = append(args, opts.Category)
+	}
+	query += " ORDER BY score DESC"
+	if opts.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, opts.Limit, opts.Offset)
+	}
+
+	rows, err := db.QueryContext(ctx, rebindDollar(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("search skills: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var r SearchResult
+		if err := rows.Scan(&r.Skill.ID, &r.Skill.Category, &r.Skill.Name, &r.Skill.Description, &r.Score, &r.Snippet); err != nil {
+			return nil, fmt.Errorf("scan search result: %w", err)
+		}
+		if r.Score < opts.MinScore {
+			continue
+		}
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate search results: %w", err)
+	}
+	return results, nil
+}
+
+// MySQLDialect targets MySQL/MariaDB. MySQL has no RETURNING clause, so
+// UpsertSkill falls back to a follow-up SELECT after the upsert rather than
+// trusting LAST_INSERT_ID, which MySQL does not populate for rows touched
+// only by the UPDATE branch of ON DUPLICATE KEY UPDATE.
+type MySQLDialect struct{}
+
+// Name implements Dialect.
+func (MySQLDialect) Name() string { return "mysql" }
+
+// Schema implements Dialect.
+func (MySQLDialect) Schema() string {
+	return `
+CREATE TABLE categories (
+    id INTEGER PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT
+);
+
+CREATE TABLE skills (
+    id INTEGER PRIMARY KEY AUTO_INCREMENT,
+    category_id INTEGER REFERENCES categories(id),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT,
+    FULLTEXT idx_skills_search (name, description)
+);
+
+CREATE TABLE parameters (
+    id INTEGER PRIMARY KEY AUTO_INCREMENT,
+    skill_id INTEGER REFERENCES skills(id),
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    description TEXT,
+    is_required BOOLEAN DEFAULT FALSE,
+    enum_values TEXT,
+    default_value TEXT,
+    items_type VARCHAR(32)
+);
+`
+}
+
+// Rebind implements Dialect. MySQL uses "?" placeholders natively.
+func (MySQLDialect) Rebind(query string) string { return query }
+
+// UpsertCategory implements Dialect.
+func (MySQLDialect) UpsertCategory(ctx context.Context, tx *sql.Tx, name string) (int64, error) {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO categories (name, description) VALUES (?, '')
+		ON DUPLICATE KEY UPDATE name = name
+	`, name); err != nil {
+		return 0, fmt.Errorf("upsert category: %w", err)
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM categories WHERE name = ?", name).Scan(&id); err != nil {
+		return 0, fmt.Errorf("get category id: %w", err)
+	}
+	return id, nil
+}
+
+// UpsertSkill implements Dialect.
+func (MySQLDialect) UpsertSkill(ctx context.Context, tx *sql.Tx, categoryID int64, name, description string) (int64, error) {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO skills (category_id, name, description)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			category_id = VALUES(category_id),
+			description = VALUES(description)
+	`, categoryID, name, description); err != nil {
+		return 0, fmt.Errorf("upsert skill: %w", err)
+	}
+	var id int64
+	if err := tx.QueryRowContext(ctx, "SELECT id FROM skills WHERE name = ?", name).Scan(&id); err != nil {
+		return 0, fmt.Errorf("get skill id: %w", err)
+	}
+	return id, nil
+}
+
+// Search implements Dialect using the FULLTEXT index declared in
+// MySQLDialect.Schema. MySQL has no built-in snippet/headline function,
+// so the snippet is the raw description, truncated.
+func (MySQLDialect) Search(ctx context.Context, db Queryer, opts SearchOptions) ([]SearchResult, error) {
+	query := `
+		SELECT s.id, c.name, s.name, s.description,
+			MATCH(s.name, s.description) AGAINST (? IN NATURAL LANGUAGE MODE) AS score,
+			LEFT(s.description, 160) AS snippet
+		FROM skills s
+		JOIN categories c ON s.category_id = c.id
+		WHERE MATCH(s.name, s.description) AGAINST (? IN NATURAL LANGUAGE MODE)
+	`
+	args := []any{opts.Query, opts.Query}
+	if opts.Category != "" {
+		query += " AND c.name = ?"
+		args = append(args, opts.Category)
+	}
+	query += " ORDER BY score DESC"
+	if opts.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, opts.Limit, opts.Offset)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search skills: %w", err)
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var r SearchResult
+		if err := rows.Scan(&r.Skill.ID, &r.Skill.Category, &r.Skill.Name, &r.Skill.Description, &r.Score, &r.Snippet); err != nil {
+			return nil, fmt.Errorf("scan search result: %w", err)
+		}
+		if r.Score < opts.MinScore {
+			continue
+		}
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate search results: %w", err)
+	}
+	return results, nil
+}
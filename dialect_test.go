@@ -0,0 +1,37 @@
+package skill
+
+import "testing"
+
+func TestRebindDollar(t *testing.T) {
+	got := rebindDollar("SELECT id FROM skills WHERE name = ? AND category_id = ?")
+	want := "SELECT id FROM skills WHERE name = $1 AND category_id = $2"
+	if got != want {
+		t.Errorf("rebindDollar() = %q, want %q", got, want)
+	}
+}
+
+func TestPostgresDialectRebind(t *testing.T) {
+	d := PostgresDialect{}
+	got := d.Rebind("WHERE a = ? OR b = ?")
+	want := "WHERE a = $1 OR b = $2"
+	if got != want {
+		t.Errorf("Rebind() = %q, want %q", got, want)
+	}
+}
+
+func TestSQLiteAndMySQLDialectsLeaveQuestionMarks(t *testing.T) {
+	query := "WHERE a = ? OR b = ?"
+	if got := (SQLiteDialect{}).Rebind(query); got != query {
+		t.Errorf("SQLiteDialect.Rebind() = %q, want unchanged %q", got, query)
+	}
+	if got := (MySQLDialect{}).Rebind(query); got != query {
+		t.Errorf("MySQLDialect.Rebind() = %q, want unchanged %q", got, query)
+	}
+}
+
+func TestDetectDialectDefaultsToSQLite(t *testing.T) {
+	store := setupTestDB(t)
+	if store.dialect.Name() != "sqlite" {
+		t.Errorf("expected sqlite dialect for modernc.org/sqlite driver, got %q", store.dialect.Name())
+	}
+}
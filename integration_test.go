@@ -0,0 +1,117 @@
+//go:build integration
+
+package skill
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// openIntegrationDB opens a connection to a real Postgres or MySQL instance
+// configured via env var, skipping the test (the sqlx-style convention)
+// when the DSN isn't set.
+func openIntegrationDB(t *testing.T, envVar, driverName string) *sql.DB {
+	dsn := os.Getenv(envVar)
+	if dsn == "" {
+		t.Skipf("%s not set, skipping %s integration test", envVar, driverName)
+	}
+
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		t.Fatalf("open %s: %v", driverName, err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Ping(); err != nil {
+		t.Fatalf("ping %s: %v", driverName, err)
+	}
+	return db
+}
+
+func TestStorePostgresIntegration(t *testing.T) {
+	db := openIntegrationDB(t, "SKILL_POSTGRES_DSN", "pgx")
+	store := NewStore(db)
+
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, "DROP SCHEMA public CASCADE; CREATE SCHEMA public"); err != nil {
+		t.Fatalf("reset schema: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, store.GetSchemaDescription()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	testStoreAgainstLiveDB(t, store)
+}
+
+func TestStoreMySQLIntegration(t *testing.T) {
+	db := openIntegrationDB(t, "SKILL_MYSQL_DSN", "mysql")
+	store := NewStore(db)
+
+	ctx := context.Background()
+	for _, table := range []string{"parameters", "skills", "categories"} {
+		if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS "+table); err != nil {
+			t.Fatalf("drop table %s: %v", table, err)
+		}
+	}
+	if _, err := db.ExecContext(ctx, store.GetSchemaDescription()); err != nil {
+		t.Fatalf("create schema: %v", err)
+	}
+
+	testStoreAgainstLiveDB(t, store)
+}
+
+// testStoreAgainstLiveDB exercises Register/GetSkillDetail/SearchSkills
+// against a real Postgres or MySQL instance, mirroring TestRegister in
+// repository_test.go.
+func testStoreAgainstLiveDB(t *testing.T, store *Store) {
+	ctx := context.Background()
+
+	skill := Skill{
+		Category:    "Integration",
+		Name:        "integration_skill",
+		Description: "A skill registered against a live database",
+		Parameters: []Parameter{
+			{Name: "param1", Type: "string", Description: "First parameter", IsRequired: true},
+		},
+	}
+
+	if err := store.Register(ctx, skill); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	saved, err := store.GetSkillDetail(ctx, "integration_skill")
+	if err != nil {
+		t.Fatalf("GetSkillDetail failed: %v", err)
+	}
+	if saved.Description != skill.Description {
+		t.Errorf("expected description %q, got %q", skill.Description, saved.Description)
+	}
+	if len(saved.Parameters) != 1 {
+		t.Fatalf("expected 1 parameter, got %d", len(saved.Parameters))
+	}
+
+	skill.Description = "Updated over Register"
+	if err := store.Register(ctx, skill); err != nil {
+		t.Fatalf("Register update failed: %v", err)
+	}
+	saved, err = store.GetSkillDetail(ctx, "integration_skill")
+	if err != nil {
+		t.Fatalf("GetSkillDetail failed: %v", err)
+	}
+	if saved.Description != skill.Description {
+		t.Errorf("expected updated description %q, got %q", skill.Description, saved.Description)
+	}
+
+	results, err := store.SearchSkills(ctx, SearchOptions{Query: "integration"})
+	if err != nil {
+		t.Fatalf("SearchSkills failed: %v", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("expected 1 search result, got %d", len(results))
+	}
+}
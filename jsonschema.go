@@ -0,0 +1,151 @@
+package skill
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONSchema is a minimal JSON Schema object, just enough to describe a
+// Skill's parameters for LLM tool-calling APIs.
+type JSONSchema struct {
+	Type       string                     `json:"type"`
+	Properties map[string]*PropertySchema `json:"properties,omitempty"`
+	Required   []string                   `json:"required,omitempty"`
+}
+
+// PropertySchema describes a single Parameter within a JSONSchema.
+type PropertySchema struct {
+	Type        string          `json:"type"`
+	Description string          `json:"description,omitempty"`
+	Enum        []string        `json:"enum,omitempty"`
+	Default     json.RawMessage `json:"default,omitempty"`
+	Items       *PropertySchema `json:"items,omitempty"`
+}
+
+// ToJSONSchema renders Skill's Parameters as a JSON Schema object describing
+// the arguments the skill accepts, suitable for embedding in an OpenAI- or
+// Anthropic-style tool descriptor's "input_schema" field.
+func (s Skill) ToJSONSchema() JSONSchema {
+	schema := JSONSchema{
+		Type:       "object",
+		Properties: make(map[string]*PropertySchema, len(s.Parameters)),
+	}
+	for _, p := range s.Parameters {
+		prop := &PropertySchema{
+			Type:        string(p.Type),
+			Description: p.Description,
+			Enum:        p.EnumValues,
+		}
+		if p.ItemsType != "" {
+			prop.Items = &PropertySchema{Type: string(p.ItemsType)}
+		}
+		if p.DefaultValue != "" {
+			prop.Default = json.RawMessage(p.DefaultValue)
+		}
+		schema.Properties[p.Name] = prop
+		if p.IsRequired {
+			schema.Required = append(schema.Required, p.Name)
+		}
+	}
+	return schema
+}
+
+// Tool is an OpenAI/Anthropic-style tool descriptor generated from a Skill.
+type Tool struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	InputSchema JSONSchema `json:"input_schema"`
+}
+
+// ExportOpenAITools renders every skill, optionally restricted to
+// categoryFilter, as a Tool descriptor ready to hand to an LLM's
+// tool-calling API. An empty categoryFilter exports skills from every
+// category.
+func (s *Store) ExportOpenAITools(ctx context.Context, categoryFilter string) ([]Tool, error) {
+	var skills []Skill
+	if categoryFilter != "" {
+		var err error
+		skills, err = s.ListSkillsByCategory(ctx, categoryFilter)
+		if err != nil {
+			return nil, fmt.Errorf("export openai tools: %w", err)
+		}
+	} else {
+		categories, err := s.ListCategories(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("export openai tools: %w", err)
+		}
+		for _, c := range categories {
+			categorySkills, err := s.ListSkillsByCategory(ctx, c.Name)
+			if err != nil {
+				return nil, fmt.Errorf("export openai tools: %w", err)
+			}
+			skills = append(skills, categorySkills...)
+		}
+	}
+
+	tools := make([]Tool, 0, len(skills))
+	for _, sk := range skills {
+		detail, err := s.GetSkillDetail(ctx, sk.Name)
+		if err != nil {
+			return nil, fmt.Errorf("export openai tools: get detail for %s: %w", sk.Name, err)
+		}
+		tools = append(tools, Tool{
+			Name:        detail.Name,
+			Description: detail.Description,
+			InputSchema: detail.ToJSONSchema(),
+		})
+	}
+	return tools, nil
+}
+
+// ImportFromJSONSchema parses a single tool descriptor (the same shape
+// ExportOpenAITools produces: name, description, input_schema) and
+// registers it as a skill under categoryName, so existing tool catalogs
+// (MCP servers, OpenAPI specs rendered into this shape) can be bulk-loaded.
+func (s *Store) ImportFromJSONSchema(ctx context.Context, categoryName string, schema []byte) error {
+	var tool Tool
+	if err := json.Unmarshal(schema, &tool); err != nil {
+		return fmt.Errorf("import from json schema: decode: %w", err)
+	}
+	if tool.Name == "" {
+		return fmt.Errorf("import from json schema: tool has no name")
+	}
+
+	required := make(map[string]bool, len(tool.InputSchema.Required))
+	for _, name := range tool.InputSchema.Required {
+		required[name] = true
+	}
+
+	sk := Skill{
+		Category:    categoryName,
+		Name:        tool.Name,
+		Description: tool.Description,
+	}
+	for name, prop := range tool.InputSchema.Properties {
+		paramType := ParameterType(prop.Type)
+		if !paramType.IsValid() {
+			return fmt.Errorf("import from json schema: parameter %q has unsupported type %q", name, prop.Type)
+		}
+
+		param := Parameter{
+			Name:        name,
+			Type:        paramType,
+			Description: prop.Description,
+			IsRequired:  required[name],
+			EnumValues:  prop.Enum,
+		}
+		if len(prop.Default) > 0 {
+			param.DefaultValue = string(prop.Default)
+		}
+		if prop.Items != nil {
+			param.ItemsType = ParameterType(prop.Items.Type)
+		}
+		sk.Parameters = append(sk.Parameters, param)
+	}
+
+	if err := s.Register(ctx, sk); err != nil {
+		return fmt.Errorf("import from json schema: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package skill
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestSkillToJSONSchema(t *testing.T) {
+	sk := Skill{
+		Name:        "convert_format",
+		Description: "Convert file format",
+		Parameters: []Parameter{
+			{Name: "source", Type: ParameterTypeString, IsRequired: true, EnumValues: []string{"csv", "json"}},
+			{Name: "retries", Type: ParameterTypeInteger, DefaultValue: "3"},
+		},
+	}
+
+	schema := sk.ToJSONSchema()
+	if schema.Type != "object" {
+		t.Errorf("expected schema type 'object', got %q", schema.Type)
+	}
+	if len(schema.Required) != 1 || schema.Required[0] != "source" {
+		t.Errorf("expected required=[source], got %v", schema.Required)
+	}
+
+	source, ok := schema.Properties["source"]
+	if !ok {
+		t.Fatalf("expected property 'source'")
+	}
+	if len(source.Enum) != 2 {
+		t.Errorf("expected 2 enum values, got %d", len(source.Enum))
+	}
+
+	retries, ok := schema.Properties["retries"]
+	if !ok {
+		t.Fatalf("expected property 'retries'")
+	}
+	if string(retries.Default) != "3" {
+		t.Errorf("expected default '3', got %q", string(retries.Default))
+	}
+}
+
+func TestRegisterRejectsInvalidParameterType(t *testing.T) {
+	store := setupTestDB(t)
+	ctx := context.Background()
+
+	err := store.Register(ctx, Skill{
+		Category: "Data",
+		Name:     "bad_skill",
+		Parameters: []Parameter{
+			{Name: "count", Type: "int"},
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected Register to reject parameter type 'int'")
+	}
+}
+
+func TestExportOpenAITools(t *testing.T) {
+	store := setupTestDB(t)
+	ctx := context.Background()
+
+	if err := store.Register(ctx, Skill{
+		Category:    "Data",
+		Name:        "convert_format",
+		Description: "Convert file format",
+		Parameters: []Parameter{
+			{Name: "source", Type: ParameterTypeString, IsRequired: true},
+		},
+	}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	tools, err := store.ExportOpenAITools(ctx, "Data")
+	if err != nil {
+		t.Fatalf("ExportOpenAITools failed: %v", err)
+	}
+	if len(tools) != 1 {
+		t.Fatalf("expected 1 tool, got %d", len(tools))
+	}
+	if tools[0].Name != "convert_format" {
+		t.Errorf("expected tool name 'convert_format', got %q", tools[0].Name)
+	}
+	if _, ok := tools[0].InputSchema.Properties["source"]; !ok {
+		t.Errorf("expected input schema to contain 'source' property")
+	}
+}
+
+func TestImportFromJSONSchemaRoundTrips(t *testing.T) {
+	store := setupTestDB(t)
+	ctx := context.Background()
+
+	toolJSON, err := json.Marshal(Tool{
+		Name:        "list_files",
+		Description: "List files in a directory",
+		InputSchema: JSONSchema{
+			Type: "object",
+			Properties: map[string]*PropertySchema{
+				"path": {Type: "string"},
+			},
+			Required: []string{"path"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("marshal tool: %v", err)
+	}
+
+	if err := store.ImportFromJSONSchema(ctx, "Data", toolJSON); err != nil {
+		t.Fatalf("ImportFromJSONSchema failed: %v", err)
+	}
+
+	sk, err := store.GetSkillDetail(ctx, "list_files")
+	if err != nil {
+		t.Fatalf("GetSkillDetail failed: %v", err)
+	}
+	if len(sk.Parameters) != 1 {
+		t.Fatalf("expected 1 parameter, got %d", len(sk.Parameters))
+	}
+	if !sk.Parameters[0].IsRequired {
+		t.Errorf("expected parameter 'path' to be required")
+	}
+}
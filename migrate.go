@@ -0,0 +1,236 @@
+package skill
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationFS holds the embedded migration scripts. They are written in
+// SQLite-specific SQL (FTS5 virtual tables, untyped INTEGER PRIMARY KEY), so
+// Migrate/MigrateTo/Rollback only work against a Store using SQLiteDialect.
+//
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// migration is a single numbered schema change with its forward (up) and
+// reverse (down) SQL.
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// loadMigrations reads the embedded migration files and orders them by
+// version.
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationFS.ReadDir("migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations dir: %w", err)
+	}
+
+	byVersion := map[int]*migration{}
+	for _, entry := range entries {
+		parts := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if parts == nil {
+			return nil, fmt.Errorf("unrecognized migration filename: %s", entry.Name())
+		}
+		version, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("parse migration version %s: %w", entry.Name(), err)
+		}
+
+		contents, err := migrationFS.ReadFile("migrations/" + entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), err)
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &migration{version: version, name: parts[2]}
+			byVersion[version] = mig
+		}
+		switch parts[3] {
+		case "up":
+			mig.up = string(contents)
+		case "down":
+			mig.down = string(contents)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+	return migrations, nil
+}
+
+// ensureMigrationsTable creates the schema_migrations bookkeeping table if
+// it doesn't already exist.
+func (s *Store) ensureMigrationsTable(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations table: %w", err)
+	}
+	return nil
+}
+
+// SchemaVersion returns the highest applied migration version, or 0 if no
+// migrations have been applied yet.
+func (s *Store) SchemaVersion(ctx context.Context) (int, error) {
+	if err := s.ensureMigrationsTable(ctx); err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&version); err != nil {
+		return 0, fmt.Errorf("get schema version: %w", err)
+	}
+	return int(version.Int64), nil
+}
+
+// Migrate applies all pending migrations, in order, up to the latest known
+// version. The embedded migrations are SQLite-specific (see the doc comment
+// on migrationFS); Migrate returns an error if s was opened against
+// any other dialect.
+func (s *Store) Migrate(ctx context.Context) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
+	return s.MigrateTo(ctx, migrations[len(migrations)-1].version)
+}
+
+// Rollback reverts the single most recently applied migration. Like Migrate,
+// this only supports SQLite.
+func (s *Store) Rollback(ctx context.Context) error {
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if current == 0 {
+		return nil
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+	var previous int
+	for _, m := range migrations {
+		if m.version < current {
+			previous = m.version
+		}
+	}
+	return s.MigrateTo(ctx, previous)
+}
+
+// MigrateTo applies or reverts migrations so the schema ends up at exactly
+// the given version. The run happens inside a single transaction so a
+// failure partway through leaves the schema untouched.
+//
+// The embedded migrations use SQLite-specific DDL (FTS5 virtual tables,
+// untyped INTEGER PRIMARY KEY), so MigrateTo only supports Store instances
+// using SQLiteDialect; Postgres and MySQL users must create the schema
+// directly from GetSchemaDescription instead.
+func (s *Store) MigrateTo(ctx context.Context, version int) error {
+	if s.dialect.Name() != "sqlite" {
+		return fmt.Errorf("migrate: versioned migrations are only supported on sqlite, store uses %q", s.dialect.Name())
+	}
+
+	if err := s.ensureMigrationsTable(ctx); err != nil {
+		return err
+	}
+
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	current, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version == current {
+		return nil
+	}
+
+	// SQLite serializes concurrent writers at the database-file level, so no
+	// additional advisory lock is needed here before applying migrations.
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if version > current {
+		for _, m := range migrations {
+			if m.version <= current || m.version > version {
+				continue
+			}
+			if err := s.applyMigration(ctx, tx, m, true); err != nil {
+				return err
+			}
+		}
+	} else {
+		for i := len(migrations) - 1; i >= 0; i-- {
+			m := migrations[i]
+			if m.version > current || m.version <= version {
+				continue
+			}
+			if err := s.applyMigration(ctx, tx, m, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit migration: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) applyMigration(ctx context.Context, tx *sql.Tx, m migration, up bool) error {
+	script := m.up
+	direction := "up"
+	if !up {
+		script = m.down
+		direction = "down"
+	}
+	if strings.TrimSpace(script) == "" {
+		return fmt.Errorf("migration %04d_%s has no %s script", m.version, m.name, direction)
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return fmt.Errorf("apply migration %04d_%s (%s): %w", m.version, m.name, direction, err)
+	}
+
+	if up {
+		_, err := tx.ExecContext(ctx, s.dialect.Rebind("INSERT INTO schema_migrations (version) VALUES (?)"), m.version)
+		if err != nil {
+			return fmt.Errorf("record migration %04d_%s: %w", m.version, m.name, err)
+		}
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, s.dialect.Rebind("DELETE FROM schema_migrations WHERE version = ?"), m.version)
+	if err != nil {
+		return fmt.Errorf("unrecord migration %04d_%s: %w", m.version, m.name, err)
+	}
+	return nil
+}
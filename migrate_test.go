@@ -0,0 +1,91 @@
+package skill
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+func setupMigratedDB(t *testing.T) *Store {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	store := NewStore(db)
+	if err := store.Migrate(context.Background()); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	return store
+}
+
+func TestMigrateAppliesAllMigrations(t *testing.T) {
+	store := setupMigratedDB(t)
+	ctx := context.Background()
+
+	version, err := store.SchemaVersion(ctx)
+	if err != nil {
+		t.Fatalf("SchemaVersion failed: %v", err)
+	}
+	if version != 4 {
+		t.Errorf("expected schema version 4, got %d", version)
+	}
+
+	// The schema should be usable exactly like GetSchemaDescription's.
+	skill := Skill{Category: "Data", Name: "convert_format", Description: "Convert file format"}
+	if err := store.Register(ctx, skill); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	store := setupMigratedDB(t)
+	ctx := context.Background()
+
+	if err := store.Migrate(ctx); err != nil {
+		t.Fatalf("second Migrate failed: %v", err)
+	}
+
+	version, err := store.SchemaVersion(ctx)
+	if err != nil {
+		t.Fatalf("SchemaVersion failed: %v", err)
+	}
+	if version != 4 {
+		t.Errorf("expected schema version 4, got %d", version)
+	}
+}
+
+func TestMigrateToAndRollback(t *testing.T) {
+	store := setupMigratedDB(t)
+	ctx := context.Background()
+
+	if err := store.MigrateTo(ctx, 1); err != nil {
+		t.Fatalf("MigrateTo(1) failed: %v", err)
+	}
+	version, err := store.SchemaVersion(ctx)
+	if err != nil {
+		t.Fatalf("SchemaVersion failed: %v", err)
+	}
+	if version != 1 {
+		t.Errorf("expected schema version 1 after MigrateTo(1), got %d", version)
+	}
+
+	if err := store.Rollback(ctx); err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	version, err = store.SchemaVersion(ctx)
+	if err != nil {
+		t.Fatalf("SchemaVersion failed: %v", err)
+	}
+	if version != 0 {
+		t.Errorf("expected schema version 0 after rollback, got %d", version)
+	}
+
+	// With the schema gone, Register should fail rather than silently no-op.
+	if err := store.Register(ctx, Skill{Category: "Data", Name: "x"}); err == nil {
+		t.Errorf("expected Register to fail after rolling back all migrations")
+	}
+}
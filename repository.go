@@ -3,19 +3,37 @@ package skill
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 )
 
 // Store provides access to the skill database.
 // It manages the storage and retrieval of skills, categories, and parameters.
 type Store struct {
-	db *sql.DB
+	db      *sql.DB
+	dialect Dialect
+}
+
+// StoreOption configures a Store at construction time.
+type StoreOption func(*Store)
+
+// WithDialect overrides the Dialect Store uses, bypassing driver
+// auto-detection. Use this when the driver can't be detected reliably, or
+// to point a driver at a non-default dialect (e.g. a Postgres-wire-compatible
+// database).
+func WithDialect(d Dialect) StoreOption {
+	return func(s *Store) { s.dialect = d }
 }
 
 // NewStore creates a new Store with the given database connection.
 // It expects the database to be initialized with the schema provided by GetSchemaDescription.
-func NewStore(db *sql.DB) *Store {
-	return &Store{db: db}
+// The SQL dialect is auto-detected from db's driver unless overridden with WithDialect.
+func NewStore(db *sql.DB, opts ...StoreOption) *Store {
+	s := &Store{db: db, dialect: detectDialect(db)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // ListCategories lists all available categories.
@@ -44,13 +62,13 @@ func (s *Store) ListCategories(ctx context.Context) ([]Category, error) {
 
 // ListSkillsByCategory lists all skills under a specific category.
 func (s *Store) ListSkillsByCategory(ctx context.Context, categoryName string) ([]Skill, error) {
-	rows, err := s.db.QueryContext(ctx, `
+	rows, err := s.db.QueryContext(ctx, s.dialect.Rebind(`
 		SELECT s.id, c.name, s.name, s.description
 		FROM skills s
 		JOIN categories c ON s.category_id = c.id
 		WHERE c.name = ?
 		ORDER BY s.name
-	`, categoryName)
+	`), categoryName)
 	if err != nil {
 		return nil, fmt.Errorf("list skills by category: %w", err)
 	}
@@ -70,47 +88,28 @@ func (s *Store) ListSkillsByCategory(ctx context.Context, categoryName string) (
 	return skills, nil
 }
 
-// SearchSkills searches for skills by name or description.
-// It performs a case-insensitive search using SQL LIKE operator.
-func (s *Store) SearchSkills(ctx context.Context, query string) ([]Skill, error) {
-	q := "%" + query + "%"
-	rows, err := s.db.QueryContext(ctx, `
-		SELECT s.id, c.name, s.name, s.description
-		FROM skills s
-		JOIN categories c ON s.category_id = c.id
-		WHERE s.name LIKE ? OR s.description LIKE ?
-	`, q, q)
-	if err != nil {
-		return nil, fmt.Errorf("search skills: %w", err)
-	}
-	defer rows.Close()
-
-	var skills []Skill
-	for rows.Next() {
-		var skill Skill
-		if err := rows.Scan(&skill.ID, &skill.Category, &skill.Name, &skill.Description); err != nil {
-			return nil, fmt.Errorf("scan skill: %w", err)
-		}
-		skills = append(skills, skill)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate skills: %w", err)
-	}
-	return skills, nil
+// SearchSkills runs a full-text search for opts.Query over skill names and
+// descriptions (on SQLite, category names as well), using whichever FTS
+// mechanism the underlying dialect provides (FTS5 on SQLite, tsvector/GIN on
+// Postgres, FULLTEXT on MySQL).
+// Results are ordered by relevance, most relevant first.
+func (s *Store) SearchSkills(ctx context.Context, opts SearchOptions) ([]SearchResult, error) {
+	return s.dialect.Search(ctx, s.db, opts)
 }
 
 // GetSkillDetail retrieves a skill with all its parameters joined.
 // It returns an error if the skill is not found.
 func (s *Store) GetSkillDetail(ctx context.Context, name string) (*Skill, error) {
-	rows, err := s.db.QueryContext(ctx, `
+	rows, err := s.db.QueryContext(ctx, s.dialect.Rebind(`
 		SELECT
 			s.id, c.name, s.name, s.description,
-			p.id, p.skill_id, p.name, p.type, p.description, p.is_required
+			p.id, p.skill_id, p.name, p.type, p.description, p.is_required,
+			p.enum_values, p.default_value, p.items_type
 		FROM skills s
 		JOIN categories c ON s.category_id = c.id
 		LEFT JOIN parameters p ON s.id = p.skill_id
 		WHERE s.name = ?
-	`, name)
+	`), name)
 	if err != nil {
 		return nil, fmt.Errorf("get skill detail: %w", err)
 	}
@@ -123,30 +122,42 @@ func (s *Store) GetSkillDetail(ctx context.Context, name string) (*Skill, error)
 		}
 
 		var (
-			pID          sql.NullInt64
-			pSkillID     sql.NullInt64
-			pName        sql.NullString
-			pType        sql.NullString
-			pDescription sql.NullString
-			pIsRequired  sql.NullBool
+			pID           sql.NullInt64
+			pSkillID      sql.NullInt64
+			pName         sql.NullString
+			pType         sql.NullString
+			pDescription  sql.NullString
+			pIsRequired   sql.NullBool
+			pEnumValues   sql.NullString
+			pDefaultValue sql.NullString
+			pItemsType    sql.NullString
 		)
 
 		if err := rows.Scan(
 			&skill.ID, &skill.Category, &skill.Name, &skill.Description,
 			&pID, &pSkillID, &pName, &pType, &pDescription, &pIsRequired,
+			&pEnumValues, &pDefaultValue, &pItemsType,
 		); err != nil {
 			return nil, fmt.Errorf("scan skill detail: %w", err)
 		}
 
 		if pID.Valid {
-			skill.Parameters = append(skill.Parameters, Parameter{
-				ID:          pID.Int64,
-				SkillID:     pSkillID.Int64,
-				Name:        pName.String,
-				Type:        pType.String,
-				Description: pDescription.String,
-				IsRequired:  pIsRequired.Bool,
-			})
+			param := Parameter{
+				ID:           pID.Int64,
+				SkillID:      pSkillID.Int64,
+				Name:         pName.String,
+				Type:         ParameterType(pType.String),
+				Description:  pDescription.String,
+				IsRequired:   pIsRequired.Bool,
+				DefaultValue: pDefaultValue.String,
+				ItemsType:    ParameterType(pItemsType.String),
+			}
+			if pEnumValues.Valid {
+				if err := json.Unmarshal([]byte(pEnumValues.String), &param.EnumValues); err != nil {
+					return nil, fmt.Errorf("unmarshal enum values for parameter %s: %w", param.Name, err)
+				}
+			}
+			skill.Parameters = append(skill.Parameters, param)
 		}
 	}
 
@@ -167,6 +178,12 @@ func (s *Store) GetSkillDetail(ctx context.Context, name string) (*Skill, error)
 // This operation is transactional: parameters are replaced atomically with the skill update.
 // The category is auto-provisioned if it does not exist.
 func (s *Store) Register(ctx context.Context, skill Skill) error {
+	for _, p := range skill.Parameters {
+		if !p.Type.IsValid() {
+			return fmt.Errorf("register: parameter %q has invalid type %q", p.Name, p.Type)
+		}
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
@@ -174,51 +191,52 @@ func (s *Store) Register(ctx context.Context, skill Skill) error {
 	defer tx.Rollback()
 
 	// 1. Upsert Category
-	if _, err := tx.ExecContext(ctx, `
-		INSERT INTO categories (name, description) VALUES (?, '')
-		ON CONFLICT(name) DO NOTHING
-	`, skill.Category); err != nil {
-		return fmt.Errorf("upsert category: %w", err)
-	}
-
-	var categoryID int64
-	if err := tx.QueryRowContext(ctx, "SELECT id FROM categories WHERE name = ?", skill.Category).Scan(&categoryID); err != nil {
-		return fmt.Errorf("get category id: %w", err)
+	categoryID, err := s.dialect.UpsertCategory(ctx, tx, skill.Category)
+	if err != nil {
+		return err
 	}
 
-	// 2. Upsert skill using SQLite ON CONFLICT clause.
-	query := `
-		INSERT INTO skills (category_id, name, description)
-		VALUES (?, ?, ?)
-		ON CONFLICT(name) DO UPDATE SET
-			category_id = excluded.category_id,
-			description = excluded.description
-		RETURNING id
-	`
-	var skillID int64
-	err = tx.QueryRowContext(ctx, query, categoryID, skill.Name, skill.Description).Scan(&skillID)
+	// 2. Upsert skill via the dialect's native upsert syntax.
+	skillID, err := s.dialect.UpsertSkill(ctx, tx, categoryID, skill.Name, skill.Description)
 	if err != nil {
-		return fmt.Errorf("upsert skill: %w", err)
+		return err
 	}
 
 	// 3. Replace parameters: delete existing and insert new ones.
-	_, err = tx.ExecContext(ctx, "DELETE FROM parameters WHERE skill_id = ?", skillID)
+	_, err = tx.ExecContext(ctx, s.dialect.Rebind("DELETE FROM parameters WHERE skill_id = ?"), skillID)
 	if err != nil {
 		return fmt.Errorf("delete parameters: %w", err)
 	}
 
 	if len(skill.Parameters) > 0 {
-		stmt, err := tx.PrepareContext(ctx, `
-			INSERT INTO parameters (skill_id, name, type, description, is_required)
-			VALUES (?, ?, ?, ?, ?)
-		`)
+		stmt, err := tx.PrepareContext(ctx, s.dialect.Rebind(`
+			INSERT INTO parameters (skill_id, name, type, description, is_required, enum_values, default_value, items_type)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`))
 		if err != nil {
 			return fmt.Errorf("prepare parameter insert: %w", err)
 		}
 		defer stmt.Close()
 
 		for _, p := range skill.Parameters {
-			_, err := stmt.ExecContext(ctx, skillID, p.Name, p.Type, p.Description, p.IsRequired)
+			var enumValues any
+			if len(p.EnumValues) > 0 {
+				b, err := json.Marshal(p.EnumValues)
+				if err != nil {
+					return fmt.Errorf("marshal enum values for parameter %s: %w", p.Name, err)
+				}
+				enumValues = string(b)
+			}
+			var defaultValue any
+			if p.DefaultValue != "" {
+				defaultValue = p.DefaultValue
+			}
+			var itemsType any
+			if p.ItemsType != "" {
+				itemsType = string(p.ItemsType)
+			}
+
+			_, err := stmt.ExecContext(ctx, skillID, p.Name, string(p.Type), p.Description, p.IsRequired, enumValues, defaultValue, itemsType)
 			if err != nil {
 				return fmt.Errorf("insert parameter %s: %w", p.Name, err)
 			}
@@ -236,28 +254,7 @@ func (s *Store) Register(ctx context.Context, skill Skill) error {
 // This includes tables for categories, skills, and parameters.
 // The 'skills' table enforces a UNIQUE constraint on the 'name' column.
 // The 'categories' table enforces a UNIQUE constraint on the 'name' column.
+// The DDL is dialect-specific; see Dialect.Schema.
 func (s *Store) GetSchemaDescription() string {
-	return `
-CREATE TABLE categories (
-    id INTEGER PRIMARY KEY,
-    name TEXT NOT NULL UNIQUE,
-    description TEXT
-);
-
-CREATE TABLE skills (
-    id INTEGER PRIMARY KEY,
-    category_id INTEGER REFERENCES categories(id),
-    name TEXT NOT NULL UNIQUE,
-    description TEXT
-);
-
-CREATE TABLE parameters (
-    id INTEGER PRIMARY KEY,
-    skill_id INTEGER REFERENCES skills(id),
-    name TEXT NOT NULL,
-    type TEXT NOT NULL,
-    description TEXT,
-    is_required BOOLEAN DEFAULT FALSE
-);
-`
+	return s.dialect.Schema()
 }
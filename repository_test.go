@@ -44,30 +44,30 @@ func TestSearchSkills(t *testing.T) {
 	seedData(t, store.db)
 
 	ctx := context.Background()
-	skills, err := store.SearchSkills(ctx, "convert")
+	results, err := store.SearchSkills(ctx, SearchOptions{Query: "convert"})
 	if err != nil {
 		t.Fatalf("SearchSkills failed: %v", err)
 	}
 
-	if len(skills) != 1 {
-		t.Errorf("expected 1 skill, got %d", len(skills))
+	if len(results) != 1 {
+		t.Errorf("expected 1 skill, got %d", len(results))
 	}
-	if skills[0].Name != "convert_format" {
-		t.Errorf("expected skill 'convert_format', got '%s'", skills[0].Name)
+	if results[0].Skill.Name != "convert_format" {
+		t.Errorf("expected skill 'convert_format', got '%s'", results[0].Skill.Name)
 	}
-	if skills[0].Category != "Data" {
-		t.Errorf("expected category 'Data', got '%s'", skills[0].Category)
+	if results[0].Skill.Category != "Data" {
+		t.Errorf("expected category 'Data', got '%s'", results[0].Skill.Category)
 	}
 
-	skills, err = store.SearchSkills(ctx, "files")
+	results, err = store.SearchSkills(ctx, SearchOptions{Query: "files"})
 	if err != nil {
 		t.Fatalf("SearchSkills failed: %v", err)
 	}
-	if len(skills) != 1 {
-		t.Errorf("expected 1 skill, got %d", len(skills))
+	if len(results) != 1 {
+		t.Errorf("expected 1 skill, got %d", len(results))
 	}
-	if skills[0].Name != "list_files" {
-		t.Errorf("expected skill 'list_files', got '%s'", skills[0].Name)
+	if results[0].Skill.Name != "list_files" {
+		t.Errorf("expected skill 'list_files', got '%s'", results[0].Skill.Name)
 	}
 }
 
@@ -186,7 +186,7 @@ func TestRegister(t *testing.T) {
 		Name:        "new_skill", // Same name
 		Description: "Updated description",
 		Parameters: []Parameter{
-			{Name: "param2", Type: "int", Description: "New parameter", IsRequired: false},
+			{Name: "param2", Type: "integer", Description: "New parameter", IsRequired: false},
 		},
 	}
 
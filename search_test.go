@@ -0,0 +1,76 @@
+package skill
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSearchSkillsWithCategoryFilter(t *testing.T) {
+	store := setupTestDB(t)
+	seedData(t, store.db)
+
+	ctx := context.Background()
+	if err := store.Register(ctx, Skill{
+		Category:    "Audio",
+		Name:        "convert_audio",
+		Description: "Convert audio format",
+	}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	results, err := store.SearchSkills(ctx, SearchOptions{Query: "convert", Category: "Audio"})
+	if err != nil {
+		t.Fatalf("SearchSkills failed: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Skill.Name != "convert_audio" {
+		t.Errorf("expected skill 'convert_audio', got '%s'", results[0].Skill.Name)
+	}
+}
+
+func TestSearchSkillsSnippet(t *testing.T) {
+	store := setupTestDB(t)
+	seedData(t, store.db)
+
+	ctx := context.Background()
+	results, err := store.SearchSkills(ctx, SearchOptions{Query: "convert"})
+	if err != nil {
+		t.Fatalf("SearchSkills failed: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	if !strings.Contains(results[0].Snippet, "[") {
+		t.Errorf("expected snippet to highlight the match, got %q", results[0].Snippet)
+	}
+}
+
+func TestSearchSkillsLimitAndOffset(t *testing.T) {
+	store := setupTestDB(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"convert_a", "convert_b", "convert_c"} {
+		if err := store.Register(ctx, Skill{Category: "Data", Name: name, Description: "Convert something"}); err != nil {
+			t.Fatalf("Register failed: %v", err)
+		}
+	}
+
+	results, err := store.SearchSkills(ctx, SearchOptions{Query: "convert", Limit: 2})
+	if err != nil {
+		t.Fatalf("SearchSkills failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 results with Limit=2, got %d", len(results))
+	}
+
+	page2, err := store.SearchSkills(ctx, SearchOptions{Query: "convert", Limit: 2, Offset: 2})
+	if err != nil {
+		t.Fatalf("SearchSkills failed: %v", err)
+	}
+	if len(page2) != 1 {
+		t.Errorf("expected 1 result on the second page, got %d", len(page2))
+	}
+}
@@ -18,8 +18,8 @@ type Category struct {
 type Skill struct {
 	// ID is the unique identifier for the skill.
 	ID int64 `json:"id"`
-	// CategoryID references the category this skill belongs to.
-	CategoryID int64 `json:"category_id"`
+	// Category is the name of the category this skill belongs to.
+	Category string `json:"category"`
 	// Name is the unique name of the skill, used for invocation.
 	Name string `json:"name"`
 	// Description explains what the skill does.
@@ -28,6 +28,32 @@ type Skill struct {
 	Parameters []Parameter `json:"parameters,omitempty"`
 }
 
+// ParameterType enumerates the JSON Schema-compatible types a Parameter may
+// declare. Register rejects any Parameter whose Type isn't one of these, so
+// that Skill.ToJSONSchema always produces a valid "type" keyword.
+type ParameterType string
+
+// The parameter types Register accepts, matching JSON Schema's primitive
+// type names.
+const (
+	ParameterTypeString  ParameterType = "string"
+	ParameterTypeInteger ParameterType = "integer"
+	ParameterTypeNumber  ParameterType = "number"
+	ParameterTypeBoolean ParameterType = "boolean"
+	ParameterTypeArray   ParameterType = "array"
+	ParameterTypeObject  ParameterType = "object"
+)
+
+// IsValid reports whether t is one of the recognized parameter types.
+func (t ParameterType) IsValid() bool {
+	switch t {
+	case ParameterTypeString, ParameterTypeInteger, ParameterTypeNumber, ParameterTypeBoolean, ParameterTypeArray, ParameterTypeObject:
+		return true
+	default:
+		return false
+	}
+}
+
 // Parameter represents an individual argument that must or can be provided
 // when invoking a skill.
 type Parameter struct {
@@ -37,10 +63,20 @@ type Parameter struct {
 	SkillID int64 `json:"skill_id"`
 	// Name is the name of the parameter.
 	Name string `json:"name"`
-	// Type defines the data type of the parameter (e.g., "string", "integer").
-	Type string `json:"type"`
+	// Type defines the data type of the parameter. Register rejects
+	// parameters whose Type isn't a recognized ParameterType.
+	Type ParameterType `json:"type"`
 	// Description explains the purpose of the parameter.
 	Description string `json:"description"`
 	// IsRequired indicates if the parameter must be provided.
 	IsRequired bool `json:"is_required"`
+	// EnumValues, if non-empty, restricts the parameter to one of these
+	// values.
+	EnumValues []string `json:"enum_values,omitempty"`
+	// DefaultValue is the parameter's default, as a JSON-encoded value
+	// (e.g. `"3"` for an integer default, `"\"utf-8\""` for a string one),
+	// so it round-trips into Skill.ToJSONSchema without guessing its type.
+	DefaultValue string `json:"default_value,omitempty"`
+	// ItemsType is the element type for a Type ParameterTypeArray parameter.
+	ItemsType ParameterType `json:"items_type,omitempty"`
 }